@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 
 	"golang.org/x/xerrors"
@@ -117,6 +118,7 @@ func (r *RootCmd) portForward() *serpent.Command {
 			var (
 				wg                = new(sync.WaitGroup)
 				listeners         = make([]net.Listener, len(specs))
+				stats             = make([]*portForwardStats, len(specs))
 				closeAllListeners = func() {
 					logger.Debug(ctx, "closing all listeners")
 					for _, l := range listeners {
@@ -130,7 +132,8 @@ func (r *RootCmd) portForward() *serpent.Command {
 			defer closeAllListeners()
 
 			for i, spec := range specs {
-				l, err := listenAndPortForward(ctx, inv, conn, wg, spec, logger)
+				stats[i] = &portForwardStats{}
+				l, err := listenAndPortForward(ctx, inv, conn, wg, spec, stats[i], logger)
 				if err != nil {
 					logger.Error(ctx, "failed to listen", slog.F("spec", spec), slog.Error(err))
 					return err
@@ -168,6 +171,12 @@ func (r *RootCmd) portForward() *serpent.Command {
 			logger.Debug(ctx, "read to accept connections to forward")
 			_, _ = fmt.Fprintln(inv.Stderr, "Ready!")
 			wg.Wait()
+
+			for i, spec := range specs {
+				_, _ = fmt.Fprintf(inv.Stderr, "Forwarded '%v://%v' to '%v://%v': %d bytes sent, %d bytes received\n",
+					spec.listenNetwork, spec.listenAddress, spec.dialNetwork, spec.dialAddress,
+					stats[i].sent.Load(), stats[i].received.Load())
+			}
 			return closeErr
 		},
 	}
@@ -198,6 +207,7 @@ func listenAndPortForward(
 	conn *workspacesdk.AgentConn,
 	wg *sync.WaitGroup,
 	spec portForwardSpec,
+	stats *portForwardStats,
 	logger slog.Logger,
 ) (net.Listener, error) {
 	logger = logger.With(slog.F("network", spec.listenNetwork), slog.F("address", spec.listenAddress))
@@ -226,7 +236,9 @@ func listenAndPortForward(
 			}
 			logger.Debug(ctx, "accepted connection", slog.F("remote_addr", netConn.RemoteAddr()))
 
+			wg.Add(1)
 			go func(netConn net.Conn) {
+				defer wg.Done()
 				defer netConn.Close()
 				remoteConn, err := conn.DialContext(ctx, spec.dialNetwork, spec.dialAddress)
 				if err != nil {
@@ -236,7 +248,7 @@ func listenAndPortForward(
 				defer remoteConn.Close()
 				logger.Debug(ctx, "dialed remote", slog.F("remote_addr", netConn.RemoteAddr()))
 
-				agentssh.Bicopy(ctx, netConn, remoteConn)
+				agentssh.Bicopy(ctx, &countingConn{Conn: netConn, stats: stats}, remoteConn)
 				logger.Debug(ctx, "connection closing", slog.F("remote_addr", netConn.RemoteAddr()))
 			}(netConn)
 		}
@@ -245,6 +257,32 @@ func listenAndPortForward(
 	return l, nil
 }
 
+// portForwardStats counts the bytes transferred through a single
+// port-forward spec across all of its connections.
+type portForwardStats struct {
+	sent     atomic.Int64 // local -> workspace
+	received atomic.Int64 // workspace -> local
+}
+
+// countingConn wraps the local side of a forwarded connection and records
+// the bytes flowing through it in stats.
+type countingConn struct {
+	net.Conn
+	stats *portForwardStats
+}
+
+func (c *countingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.stats.sent.Add(int64(n))
+	return n, err
+}
+
+func (c *countingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.stats.received.Add(int64(n))
+	return n, err
+}
+
 type portForwardSpec struct {
 	listenNetwork string // tcp, udp
 	listenAddress string // <ip>:<port> or path
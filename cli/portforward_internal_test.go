@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"io"
+	"net"
 	"strings"
 	"testing"
 
@@ -88,3 +90,30 @@ func Test_parsePortForwards(t *testing.T) {
 		})
 	}
 }
+
+func Test_countingConn(t *testing.T) {
+	t.Parallel()
+
+	local, remote := net.Pipe()
+	defer remote.Close()
+
+	stats := &portForwardStats{}
+	conn := &countingConn{Conn: local, stats: stats}
+	defer conn.Close()
+
+	go func() {
+		_, _ = remote.Write([]byte("hello"))
+	}()
+	buf := make([]byte, 5)
+	_, err := io.ReadFull(conn, buf)
+	require.NoError(t, err)
+
+	go func() {
+		_, _ = io.ReadFull(remote, make([]byte, 3))
+	}()
+	_, err = conn.Write([]byte("abc"))
+	require.NoError(t, err)
+
+	require.EqualValues(t, 5, stats.sent.Load())
+	require.EqualValues(t, 3, stats.received.Load())
+}
@@ -155,6 +155,11 @@ func TestPortForward(t *testing.T) {
 			err = <-errC
 			require.ErrorIs(t, err, context.Canceled)
 
+			// Both connections sent and received one payload each.
+			summaryCtx := testutil.Context(t, testutil.WaitShort)
+			pty.ExpectMatchContext(summaryCtx, fmt.Sprintf("%d bytes sent, %d bytes received",
+				2*len(dialTestPayload), 2*len(dialTestPayload)))
+
 			flushCtx := testutil.Context(t, testutil.WaitShort)
 			testutil.RequireSendCtx(flushCtx, t, wuTick, dbtime.Now())
 			_ = testutil.RequireRecvCtx(flushCtx, t, wuFlush)
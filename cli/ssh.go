@@ -61,10 +61,20 @@ func (r *RootCmd) ssh() *serpent.Command {
 	client := new(codersdk.Client)
 	cmd := &serpent.Command{
 		Annotations: workspaceCommand,
-		Use:         "ssh <workspace>",
-		Short:       "Start a shell into a workspace",
+		Use:         "ssh <workspace> [-- <command>]",
+		Short:       "Start a shell into a workspace or run a command",
+		Long: "Connect to a workspace via SSH. If a command is given, it is run " +
+			"in the workspace without a terminal and its exit code is returned. " +
+			"Separate the command with \"--\" so that its flags are not parsed " +
+			"as flags of coder ssh.\n" +
+			formatExamples(
+				example{
+					Description: "Run a command in a workspace",
+					Command:     "coder ssh my-workspace -- ls -la",
+				},
+			),
 		Middleware: serpent.Chain(
-			serpent.RequireNArgs(1),
+			serpent.RequireRangeArgs(1, -1),
 			r.InitClient(client),
 		),
 		Handler: func(inv *serpent.Invocation) (retErr error) {
@@ -156,6 +166,9 @@ func (r *RootCmd) ssh() *serpent.Command {
 					}
 				}
 			}
+			if stdio && len(inv.Args) > 1 {
+				return xerrors.Errorf(`a command can't be run in the stdio mode`)
+			}
 
 			workspace, workspaceAgent, err := getWorkspaceAndAgent(ctx, inv, client, !disableAutostart, codersdk.Me, inv.Args[0])
 			if err != nil {
@@ -341,6 +354,21 @@ func (r *RootCmd) ssh() *serpent.Command {
 				}
 			}
 
+			sshSession.Stdin = inv.Stdin
+			sshSession.Stdout = inv.Stdout
+			sshSession.Stderr = inv.Stderr
+
+			// A command runs without a pty so that stdout and stderr stay
+			// separate, like "ssh host command".
+			if len(inv.Args) > 1 {
+				err = sshSession.Start(strings.Join(inv.Args[1:], " "))
+				if err != nil {
+					return xerrors.Errorf("start command: %w", err)
+				}
+				defer cancel()
+				return sshSessionWait(sshSession)
+			}
+
 			stdoutFile, validOut := inv.Stdout.(*os.File)
 			stdinFile, validIn := inv.Stdin.(*os.File)
 			if validOut && validIn && isatty.IsTerminal(stdoutFile.Fd()) {
@@ -374,10 +402,6 @@ func (r *RootCmd) ssh() *serpent.Command {
 				return xerrors.Errorf("request pty: %w", err)
 			}
 
-			sshSession.Stdin = inv.Stdin
-			sshSession.Stdout = inv.Stdout
-			sshSession.Stderr = inv.Stderr
-
 			err = sshSession.Shell()
 			if err != nil {
 				return xerrors.Errorf("start shell: %w", err)
@@ -395,23 +419,7 @@ func (r *RootCmd) ssh() *serpent.Command {
 				}
 			}
 
-			err = sshSession.Wait()
-			if err != nil {
-				if exitErr := (&gossh.ExitError{}); errors.As(err, &exitErr) {
-					// Clear the error since it's not useful beyond
-					// reporting status.
-					return ExitError(exitErr.ExitStatus(), nil)
-				}
-				// If the connection drops unexpectedly, we get an
-				// ExitMissingError but no other error details, so try to at
-				// least give the user a better message
-				if errors.Is(err, &gossh.ExitMissingError{}) {
-					return ExitError(255, xerrors.New("SSH connection ended unexpectedly"))
-				}
-				return xerrors.Errorf("session ended: %w", err)
-			}
-
-			return nil
+			return sshSessionWait(sshSession)
 		},
 	}
 	waitOption := serpent.Option{
@@ -482,6 +490,28 @@ func (r *RootCmd) ssh() *serpent.Command {
 	return cmd
 }
 
+// sshSessionWait waits for the remote shell or command to exit and
+// converts its exit status into a CLI exit code.
+func sshSessionWait(sshSession *gossh.Session) error {
+	err := sshSession.Wait()
+	if err != nil {
+		if exitErr := (&gossh.ExitError{}); errors.As(err, &exitErr) {
+			// Clear the error since it's not useful beyond
+			// reporting status.
+			return ExitError(exitErr.ExitStatus(), nil)
+		}
+		// If the connection drops unexpectedly, we get an
+		// ExitMissingError but no other error details, so try to at
+		// least give the user a better message
+		if errors.Is(err, &gossh.ExitMissingError{}) {
+			return ExitError(255, xerrors.New("SSH connection ended unexpectedly"))
+		}
+		return xerrors.Errorf("session ended: %w", err)
+	}
+
+	return nil
+}
+
 // watchAndClose ensures closer is called if the context is canceled or
 // the workspace reaches the stopped state.
 //
@@ -92,6 +92,81 @@ func TestSSH(t *testing.T) {
 		pty.WriteLine("exit")
 		<-cmdDone
 	})
+	t.Run("Command", func(t *testing.T) {
+		t.Parallel()
+
+		client, workspace, agentToken := setupWorkspaceForAgent(t)
+		_ = agenttest.New(t, client.URL, agentToken)
+		coderdtest.AwaitWorkspaceAgents(t, client, workspace.ID)
+
+		inv, root := clitest.New(t, "ssh", workspace.Name, "echo", "hello")
+		clitest.SetupConfig(t, client, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		err := inv.WithContext(ctx).Run()
+		require.NoError(t, err)
+		require.Contains(t, stdout.String(), "hello")
+	})
+	t.Run("CommandWithFlags", func(t *testing.T) {
+		t.Parallel()
+		if runtime.GOOS == "windows" {
+			t.Skip("Test uses a POSIX shell command")
+		}
+
+		client, workspace, agentToken := setupWorkspaceForAgent(t)
+		_ = agenttest.New(t, client.URL, agentToken)
+		coderdtest.AwaitWorkspaceAgents(t, client, workspace.ID)
+
+		// -A and -l are also coder ssh flags, so they must only reach the
+		// command when it follows "--".
+		inv, root := clitest.New(t, "ssh", workspace.Name, "--", "echo", "-A", "-l", "hello")
+		clitest.SetupConfig(t, client, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		err := inv.WithContext(ctx).Run()
+		require.NoError(t, err)
+		require.Contains(t, stdout.String(), "-A -l hello")
+	})
+	t.Run("CommandExitCode", func(t *testing.T) {
+		t.Parallel()
+		if runtime.GOOS == "windows" {
+			t.Skip("Test uses a POSIX shell command")
+		}
+
+		client, workspace, agentToken := setupWorkspaceForAgent(t)
+		_ = agenttest.New(t, client.URL, agentToken)
+		coderdtest.AwaitWorkspaceAgents(t, client, workspace.ID)
+
+		inv, root := clitest.New(t, "ssh", workspace.Name, "--", "echo out; echo err >&2; exit 3")
+		clitest.SetupConfig(t, client, root)
+		var stdout, stderr bytes.Buffer
+		inv.Stdout = &stdout
+		inv.Stderr = &stderr
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		err := inv.WithContext(ctx).Run()
+		require.EqualError(t, err, "exit code 3")
+		require.Equal(t, "out\n", stdout.String())
+		// Stderr also carries the agent connection status.
+		require.Contains(t, stderr.String(), "err\n")
+		require.NotContains(t, stderr.String(), "out\n")
+	})
+	t.Run("CommandStdio", func(t *testing.T) {
+		t.Parallel()
+
+		client, workspace, _ := setupWorkspaceForAgent(t)
+
+		inv, root := clitest.New(t, "ssh", "--stdio", workspace.Name, "--", "echo", "hello")
+		clitest.SetupConfig(t, client, root)
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		err := inv.WithContext(ctx).Run()
+		require.ErrorContains(t, err, "a command can't be run in the stdio mode")
+	})
 	t.Run("StartStoppedWorkspace", func(t *testing.T) {
 		t.Parallel()
 
@@ -32,6 +32,7 @@ func (r *RootCmd) templateVersions() *serpent.Command {
 			r.templateVersionsList(),
 			r.archiveTemplateVersion(),
 			r.unarchiveTemplateVersion(),
+			r.promoteTemplateVersion(),
 		},
 	}
 
@@ -126,6 +127,60 @@ func (r *RootCmd) templateVersionsList() *serpent.Command {
 	return cmd
 }
 
+func (r *RootCmd) promoteTemplateVersion() *serpent.Command {
+	client := new(codersdk.Client)
+	cmd := &serpent.Command{
+		Use:   "promote <template-name> <template-version-name>",
+		Short: "Promote a template version to active.",
+		Long: formatExamples(
+			example{
+				Description: "Roll a template back to a previous version",
+				Command:     "coder templates versions promote my-template v1",
+			},
+		),
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(2),
+			r.InitClient(client),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			organization, err := CurrentOrganization(r, inv, client)
+			if err != nil {
+				return xerrors.Errorf("get current organization: %w", err)
+			}
+			template, err := client.TemplateByName(ctx, organization.ID, inv.Args[0])
+			if err != nil {
+				return xerrors.Errorf("get template by name: %w", err)
+			}
+			version, err := client.TemplateVersionByOrganizationAndName(ctx, organization.ID, template.Name, inv.Args[1])
+			if err != nil {
+				return xerrors.Errorf("get template version by name %q: %w", inv.Args[1], err)
+			}
+
+			if version.ID == template.ActiveVersionID {
+				_, _ = fmt.Fprintln(
+					inv.Stdout, "Version "+pretty.Sprint(cliui.DefaultStyles.Keyword, version.Name)+" is already active",
+				)
+				return nil
+			}
+
+			err = client.UpdateActiveTemplateVersion(ctx, template.ID, codersdk.UpdateActiveTemplateVersion{
+				ID: version.ID,
+			})
+			if err != nil {
+				return xerrors.Errorf("promote template version %q: %w", version.Name, err)
+			}
+
+			_, _ = fmt.Fprintln(
+				inv.Stdout, "Version "+pretty.Sprint(cliui.DefaultStyles.Keyword, version.Name)+" promoted to active at "+cliui.Timestamp(time.Now()),
+			)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
 type templateVersionRow struct {
 	// For json format:
 	TemplateVersion codersdk.TemplateVersion `table:"-"`
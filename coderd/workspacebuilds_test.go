@@ -992,6 +992,7 @@ func TestPostWorkspaceBuild(t *testing.T) {
 		var apiErr *codersdk.Error
 		require.ErrorAs(t, err, &apiErr)
 		require.Equal(t, http.StatusConflict, apiErr.StatusCode())
+		require.Contains(t, apiErr.Detail, workspace.LatestBuild.ID.String())
 	})
 
 	t.Run("Audit", func(t *testing.T) {
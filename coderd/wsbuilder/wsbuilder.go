@@ -757,10 +757,14 @@ func (b *Builder) checkRunningBuild() error {
 	}
 	if codersdk.ProvisionerJobStatus(job.JobStatus).Active() {
 		msg := "A workspace build is already active."
+		bld, err := b.getLastBuild()
+		if err != nil {
+			return BuildError{http.StatusInternalServerError, "failed to fetch prior build", err}
+		}
 		return BuildError{
 			http.StatusConflict,
 			msg,
-			xerrors.New(msg),
+			xerrors.Errorf("workspace build %s is %s", bld.ID, job.JobStatus),
 		}
 	}
 	return nil
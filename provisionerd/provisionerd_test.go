@@ -26,6 +26,7 @@ import (
 	"github.com/coder/coder/v2/codersdk/drpc"
 	"github.com/coder/coder/v2/provisionerd"
 	"github.com/coder/coder/v2/provisionerd/proto"
+	"github.com/coder/coder/v2/provisionerd/runner"
 	"github.com/coder/coder/v2/provisionersdk"
 	sdkproto "github.com/coder/coder/v2/provisionersdk/proto"
 	"github.com/coder/coder/v2/testutil"
@@ -456,6 +457,7 @@ func TestProvisionerd(t *testing.T) {
 			didComplete atomic.Bool
 			didLog      atomic.Bool
 			didFail     atomic.Bool
+			failedCode  atomic.String
 			acq         = newAcquireOne(t, &proto.AcquiredJob{
 				JobId:       "test",
 				Provisioner: "someprovisioner",
@@ -490,6 +492,7 @@ func TestProvisionerd(t *testing.T) {
 				},
 				failJob: func(ctx context.Context, job *proto.FailedJob) (*proto.Empty, error) {
 					didFail.Store(true)
+					failedCode.Store(job.ErrorCode)
 					return &proto.Empty{}, nil
 				},
 			}), nil
@@ -536,6 +539,7 @@ func TestProvisionerd(t *testing.T) {
 		assert.True(t, didLog.Load(), "should log some updates")
 		assert.False(t, didComplete.Load(), "should not complete the job")
 		assert.True(t, didFail.Load(), "should fail the job")
+		assert.Equal(t, runner.InsufficientQuotaErrorCode, failedCode.Load())
 	})
 
 	t.Run("WorkspaceBuildFailComplete", func(t *testing.T) {
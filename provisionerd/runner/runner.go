@@ -31,6 +31,11 @@ const (
 
 	RequiredTemplateVariablesErrorCode = "REQUIRED_TEMPLATE_VARIABLES"
 	requiredTemplateVariablesErrorText = "required template variables"
+
+	// InsufficientQuotaErrorCode is set directly by commitQuota rather
+	// than matched from the error text, since provider errors passing
+	// through failedJobf may contain the same words.
+	InsufficientQuotaErrorCode = "INSUFFICIENT_QUOTA"
 )
 
 var errorCodes = map[string]string{
@@ -896,7 +901,9 @@ func (r *Runner) commitQuota(ctx context.Context, resources []*sdkproto.Resource
 			Output:    "This build would exceed your quota. Failing.",
 			Stage:     stage,
 		})
-		return r.failedWorkspaceBuildf("insufficient quota")
+		failedJob := r.failedWorkspaceBuildf("insufficient quota")
+		failedJob.ErrorCode = InsufficientQuotaErrorCode
+		return failedJob
 	}
 	return nil
 }
@@ -2,9 +2,12 @@ package provisionersdk
 
 import (
 	"archive/tar"
+	"bufio"
+	"bytes"
 	"context"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -18,15 +21,24 @@ import (
 const (
 	// TemplateArchiveLimit represents the maximum size of a template in bytes.
 	TemplateArchiveLimit = 1 << 20
+
+	// TemplateIgnoreFile is an optional file in the root of a template
+	// directory listing gitignore-style patterns of paths that should not
+	// be archived. Patterns use path.Match syntax, so "**" and negation
+	// are not supported.
+	TemplateIgnoreFile = ".coderignore"
 )
 
-func dirHasExt(dir string, exts ...string) (bool, error) {
+func dirHasExt(dir string, ignorePatterns []ignorePattern, exts ...string) (bool, error) {
 	dirEnts, err := os.ReadDir(dir)
 	if err != nil {
 		return false, err
 	}
 
 	for _, fi := range dirEnts {
+		if isIgnored(ignorePatterns, fi.Name(), fi.IsDir()) {
+			continue
+		}
 		for _, ext := range exts {
 			if strings.HasSuffix(fi.Name(), ext) {
 				return true, nil
@@ -38,17 +50,99 @@ func dirHasExt(dir string, exts ...string) (bool, error) {
 }
 
 func DirHasLockfile(dir string) (bool, error) {
-	return dirHasExt(dir, ".terraform.lock.hcl")
+	return dirHasExt(dir, nil, ".terraform.lock.hcl")
+}
+
+// ignorePattern is a single entry parsed from a TemplateIgnoreFile.
+type ignorePattern struct {
+	pattern string
+	// dirOnly patterns end with a slash and only match directories.
+	dirOnly bool
+	// anchored patterns contain a slash and are matched against the path
+	// relative to the template root instead of the file name.
+	anchored bool
+}
+
+// readIgnorePatterns parses the TemplateIgnoreFile in directory, if any.
+// Blank lines and lines starting with "#" are skipped. Negated patterns
+// ("!") and "**" are not supported.
+func readIgnorePatterns(directory string) ([]ignorePattern, error) {
+	data, err := os.ReadFile(filepath.Join(directory, TemplateIgnoreFile))
+	if err != nil {
+		if xerrors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, xerrors.Errorf("read %s: %w", TemplateIgnoreFile, err)
+	}
+
+	var patterns []ignorePattern
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "!") {
+			return nil, xerrors.Errorf("negated pattern %q in %s is not supported", line, TemplateIgnoreFile)
+		}
+		if strings.Contains(line, "**") {
+			// path.Match treats "**" as "*", which would silently stop at
+			// the first path separator.
+			return nil, xerrors.Errorf("pattern %q in %s uses \"**\", which is not supported", line, TemplateIgnoreFile)
+		}
+		var p ignorePattern
+		if strings.HasSuffix(line, "/") {
+			p.dirOnly = true
+			line = strings.TrimSuffix(line, "/")
+		}
+		if strings.Contains(line, "/") {
+			p.anchored = true
+			line = strings.TrimPrefix(line, "/")
+		}
+		if _, err := path.Match(line, ""); err != nil {
+			return nil, xerrors.Errorf("invalid pattern %q in %s: %w", line, TemplateIgnoreFile, err)
+		}
+		p.pattern = line
+		patterns = append(patterns, p)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("read %s: %w", TemplateIgnoreFile, err)
+	}
+	return patterns, nil
+}
+
+// isIgnored reports whether the slash-separated path rel matches any of
+// the patterns.
+func isIgnored(patterns []ignorePattern, rel string, isDir bool) bool {
+	for _, p := range patterns {
+		if p.dirOnly && !isDir {
+			continue
+		}
+		name := path.Base(rel)
+		if p.anchored {
+			name = rel
+		}
+		if ok, _ := path.Match(p.pattern, name); ok {
+			return true
+		}
+	}
+	return false
 }
 
-// Tar archives a Terraform directory.
+// Tar archives a Terraform directory. Paths matching the patterns in the
+// directory's TemplateIgnoreFile are excluded.
 func Tar(w io.Writer, logger slog.Logger, directory string, limit int64) error {
 	// The total bytes written must be under the limit, so use -1
 	w = xio.NewLimitWriter(w, limit-1)
 	tarWriter := tar.NewWriter(w)
 
+	ignorePatterns, err := readIgnorePatterns(directory)
+	if err != nil {
+		return err
+	}
+
 	tfExts := []string{".tf", ".tf.json"}
-	hasTf, err := dirHasExt(directory, tfExts...)
+	hasTf, err := dirHasExt(directory, ignorePatterns, tfExts...)
 	if err != nil {
 		return err
 	}
@@ -60,6 +154,12 @@ func Tar(w io.Writer, logger slog.Logger, directory string, limit int64) error {
 
 		// Show absolute path to aid in debugging. E.g. showing "." is
 		// useless.
+		if hasIgnoredTf, _ := dirHasExt(directory, nil, tfExts...); hasIgnoredTf {
+			return xerrors.Errorf(
+				"%s is not a valid template since %s excludes all of its %s files",
+				absPath, TemplateIgnoreFile, tfExts,
+			)
+		}
 		return xerrors.Errorf(
 			"%s is not a valid template since it has no %s files",
 			absPath, tfExts,
@@ -95,6 +195,13 @@ func Tar(w io.Writer, logger slog.Logger, directory string, limit int64) error {
 			// Don't archive hidden files!
 			return nil
 		}
+		if rel != "." && isIgnored(ignorePatterns, filepath.ToSlash(rel), fileInfo.IsDir()) {
+			logger.Debug(context.Background(), "skip ignored", slog.F("name", rel))
+			if fileInfo.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
 		if strings.Contains(rel, ".tfstate") {
 			// Don't store tfstate!
 			logger.Debug(context.Background(), "skip state", slog.F("name", rel))
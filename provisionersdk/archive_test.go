@@ -180,6 +180,82 @@ func TestTar(t *testing.T) {
 			}
 		}
 	})
+	t.Run("IgnoreFile", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		ignore := strings.Join([]string{
+			"# Comments and blank lines are skipped.",
+			"",
+			"*.log",
+			"build/",
+			"docs/draft.md",
+		}, "\n")
+		err := os.WriteFile(filepath.Join(dir, provisionersdk.TemplateIgnoreFile), []byte(ignore), 0o600)
+		require.NoError(t, err)
+		files := map[string]bool{
+			"main.tf":          true,
+			"debug.log":        false,
+			"nested/trace.log": false,
+			"build/out.txt":    false,
+			"docs/draft.md":    false,
+			"docs/readme.md":   true,
+			"nested/draft.md":  true,
+		}
+		for name := range files {
+			name = filepath.FromSlash(name)
+			err := os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0o755)
+			require.NoError(t, err)
+			err = os.WriteFile(filepath.Join(dir, name), []byte{}, 0o600)
+			require.NoError(t, err)
+		}
+		archive := new(bytes.Buffer)
+		err = provisionersdk.Tar(archive, log, dir, 1024<<3)
+		require.NoError(t, err)
+		dir = t.TempDir()
+		err = provisionersdk.Untar(dir, archive)
+		require.NoError(t, err)
+		for name, archives := range files {
+			_, err = os.Stat(filepath.Join(dir, filepath.FromSlash(name)))
+			if archives {
+				require.NoError(t, err, "stat %q, got error: %+v", name, err)
+			} else {
+				require.ErrorIs(t, err, os.ErrNotExist, "stat %q, expected ErrNotExist, got: %+v", name, err)
+			}
+		}
+	})
+	t.Run("IgnoreFileNegation", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		file, err := os.CreateTemp(dir, "*.tf")
+		require.NoError(t, err)
+		_ = file.Close()
+		err = os.WriteFile(filepath.Join(dir, provisionersdk.TemplateIgnoreFile), []byte("!main.tf\n"), 0o600)
+		require.NoError(t, err)
+		err = provisionersdk.Tar(io.Discard, log, dir, 1024<<3)
+		require.Error(t, err)
+	})
+	t.Run("IgnoreFileDoubleStar", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		file, err := os.CreateTemp(dir, "*.tf")
+		require.NoError(t, err)
+		_ = file.Close()
+		err = os.WriteFile(filepath.Join(dir, provisionersdk.TemplateIgnoreFile), []byte("**/*.log\n"), 0o600)
+		require.NoError(t, err)
+		err = provisionersdk.Tar(io.Discard, log, dir, 1024<<3)
+		require.ErrorContains(t, err, "**")
+	})
+	t.Run("IgnoreFileAllTerraform", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		file, err := os.CreateTemp(dir, "*.tf")
+		require.NoError(t, err)
+		_ = file.Close()
+		err = os.WriteFile(filepath.Join(dir, provisionersdk.TemplateIgnoreFile), []byte("*.tf\n"), 0o600)
+		require.NoError(t, err)
+		err = provisionersdk.Tar(io.Discard, log, dir, 1024<<3)
+		require.ErrorContains(t, err, provisionersdk.TemplateIgnoreFile)
+	})
 }
 
 func TestUntar(t *testing.T) {
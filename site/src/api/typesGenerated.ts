@@ -1951,8 +1951,13 @@ export const InsightsReportIntervals: InsightsReportInterval[] = [
 ];
 
 // From codersdk/provisionerdaemons.go
-export type JobErrorCode = "REQUIRED_TEMPLATE_VARIABLES";
-export const JobErrorCodes: JobErrorCode[] = ["REQUIRED_TEMPLATE_VARIABLES"];
+export type JobErrorCode =
+  | "INSUFFICIENT_QUOTA"
+  | "REQUIRED_TEMPLATE_VARIABLES";
+export const JobErrorCodes: JobErrorCode[] = [
+  "INSUFFICIENT_QUOTA",
+  "REQUIRED_TEMPLATE_VARIABLES",
+];
 
 // From codersdk/provisionerdaemons.go
 export type LogLevel = "debug" | "error" | "info" | "trace" | "warn";